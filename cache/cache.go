@@ -0,0 +1,40 @@
+// Package cache provides pluggable response caches for the igdb
+// client. IGDB enforces tight per-month request quotas, so caching
+// entity responses and revalidating them with conditional requests
+// goes a long way toward staying under quota.
+package cache
+
+import "time"
+
+// Entry is a single cached response. ETag and LastModified, when
+// present, are replayed as If-None-Match / If-Modified-Since on the
+// next request for the same key once Expires has passed, so a 304
+// response can refresh Expires without re-fetching the body. TTL is
+// the duration Expires was computed from (e.g. via SetCacheTTL); a
+// background refresh reapplies it rather than substituting its own
+// poll interval, so a custom per-entity TTL survives repeated
+// refreshes.
+type Entry struct {
+	Body         []byte
+	Expires      time.Time
+	TTL          time.Duration
+	ETag         string
+	LastModified string
+}
+
+// Cache stores Entries keyed by the fully-encoded request URL.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the entry stored for key and whether it was found.
+	Get(key string) (Entry, bool)
+
+	// Set stores entry for key, replacing any entry already there.
+	Set(key string, entry Entry)
+
+	// Delete removes key from the cache, if present.
+	Delete(key string)
+
+	// Keys returns every key currently stored, for use by a
+	// CacheMonitor walking entries nearing expiry.
+	Keys() []string
+}
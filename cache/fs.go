@@ -0,0 +1,108 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// FS is a Cache backed by one JSON file per entry under Dir, for
+// callers who want a cache that survives process restarts without
+// pulling in a database dependency.
+type FS struct {
+	dir string
+
+	mu sync.Mutex
+}
+
+// NewFS returns an FS that stores entries under dir, creating it if it
+// does not already exist.
+func NewFS(dir string) (*FS, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FS{dir: dir}, nil
+}
+
+// path returns the file path an entry for key is stored at. Keys are
+// request URLs, so they are hashed into a filesystem-safe name.
+func (c *FS) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// fsRecord is the on-disk representation of an Entry; it also keeps
+// the original key so Keys can report it back without reversing the
+// hash in path.
+type fsRecord struct {
+	Key   string `json:"key"`
+	Entry Entry  `json:"entry"`
+}
+
+// Get implements Cache.
+func (c *FS) Get(key string) (Entry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return Entry{}, false
+	}
+
+	var rec fsRecord
+	if err := json.Unmarshal(b, &rec); err != nil {
+		return Entry{}, false
+	}
+	return rec.Entry, true
+}
+
+// Set implements Cache.
+func (c *FS) Set(key string, entry Entry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, err := json.Marshal(fsRecord{Key: key, Entry: entry})
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(c.path(key), b, 0o644)
+}
+
+// Delete implements Cache.
+func (c *FS) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_ = os.Remove(c.path(key))
+}
+
+// Keys implements Cache.
+func (c *FS) Keys() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		b, err := os.ReadFile(filepath.Join(c.dir, e.Name()))
+		if err != nil {
+			continue
+		}
+		var rec fsRecord
+		if err := json.Unmarshal(b, &rec); err != nil {
+			continue
+		}
+		keys = append(keys, rec.Key)
+	}
+	return keys
+}
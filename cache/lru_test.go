@@ -0,0 +1,117 @@
+package cache
+
+import "testing"
+
+func TestLRU_GetSetRoundTrip(t *testing.T) {
+	c := NewLRU(2)
+	c.Set("a", Entry{Body: []byte("a-body")})
+
+	entry, ok := c.Get("a")
+	if !ok {
+		t.Fatalf("got ok: <false>, want: <true>")
+	}
+	if string(entry.Body) != "a-body" {
+		t.Fatalf("got: <%s>, want: <a-body>", entry.Body)
+	}
+
+	if _, ok := c.Get("missing"); ok {
+		t.Fatalf("got ok: <true>, want: <false>")
+	}
+}
+
+func TestLRU_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewLRU(2)
+	c.Set("a", Entry{})
+	c.Set("b", Entry{})
+	c.Set("c", Entry{}) // over capacity, should evict "a"
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("got ok: <true>, want: <false> (a should have been evicted)")
+	}
+	if _, ok := c.Get("b"); !ok {
+		t.Fatalf("got ok: <false>, want: <true> (b should still be present)")
+	}
+	if _, ok := c.Get("c"); !ok {
+		t.Fatalf("got ok: <false>, want: <true> (c should still be present)")
+	}
+}
+
+func TestLRU_GetRefreshesRecency(t *testing.T) {
+	c := NewLRU(2)
+	c.Set("a", Entry{})
+	c.Set("b", Entry{})
+
+	c.Get("a") // touch "a" so "b" becomes the least recently used
+
+	c.Set("c", Entry{}) // over capacity, should evict "b" not "a"
+
+	if _, ok := c.Get("b"); ok {
+		t.Fatalf("got ok: <true>, want: <false> (b should have been evicted)")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Fatalf("got ok: <false>, want: <true> (a should still be present)")
+	}
+}
+
+func TestLRU_SetExistingKeyUpdatesWithoutEviction(t *testing.T) {
+	c := NewLRU(2)
+	c.Set("a", Entry{Body: []byte("v1")})
+	c.Set("b", Entry{})
+	c.Set("a", Entry{Body: []byte("v2")})
+
+	entry, ok := c.Get("a")
+	if !ok {
+		t.Fatalf("got ok: <false>, want: <true>")
+	}
+	if string(entry.Body) != "v2" {
+		t.Fatalf("got: <%s>, want: <v2>", entry.Body)
+	}
+	if _, ok := c.Get("b"); !ok {
+		t.Fatalf("got ok: <false>, want: <true> (b should not have been evicted)")
+	}
+}
+
+func TestLRU_Delete(t *testing.T) {
+	c := NewLRU(2)
+	c.Set("a", Entry{})
+	c.Delete("a")
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("got ok: <true>, want: <false>")
+	}
+
+	keys := c.Keys()
+	if len(keys) != 0 {
+		t.Fatalf("got %d keys, want: <0>", len(keys))
+	}
+}
+
+func TestLRU_ZeroCapacityIsUnbounded(t *testing.T) {
+	c := NewLRU(0)
+	for i := 0; i < 100; i++ {
+		c.Set(string(rune('a'+i%26))+string(rune(i)), Entry{})
+	}
+
+	if len(c.Keys()) != 100 {
+		t.Fatalf("got %d keys, want: <100>", len(c.Keys()))
+	}
+}
+
+func TestLRU_Keys(t *testing.T) {
+	c := NewLRU(0)
+	c.Set("a", Entry{})
+	c.Set("b", Entry{})
+
+	keys := c.Keys()
+	if len(keys) != 2 {
+		t.Fatalf("got %d keys, want: <2>", len(keys))
+	}
+
+	found := map[string]bool{}
+	for _, k := range keys {
+		found[k] = true
+	}
+	if !found["a"] || !found["b"] {
+		t.Fatalf("got: <%v>, want to contain both <a> and <b>", keys)
+	}
+}
@@ -0,0 +1,190 @@
+package igdb
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pandoratoolbox/igdb/cache"
+)
+
+// defaultCacheTTL is how long a cached entry is considered fresh when
+// the caller does not override it with SetCacheTTL.
+const defaultCacheTTL = 5 * time.Minute
+
+// cacheWrite carries the key and TTL a successful fetch should be
+// stored under; a nil *cacheWrite means "don't cache this response".
+type cacheWrite struct {
+	key string
+	ttl time.Duration
+}
+
+// SetCache installs c as the client's response cache. A nil Cache (the
+// default) disables caching entirely, leaving every request to hit the
+// network as before.
+func (c *Client) SetCache(ca cache.Cache) {
+	c.cache = ca
+}
+
+// cacheKey derives the cache key for url: the fully-encoded URL plus a
+// hash of the configured API key, so two clients authenticated as
+// different users never share entries.
+func (c *Client) cacheKey(url string) string {
+	sum := sha256.Sum256([]byte(APIkey))
+	return url + "#" + hex.EncodeToString(sum[:8])
+}
+
+// cacheSettings extracts the TTL and bypass overrides, if any, set by
+// SetCacheTTL / SetCacheBypass among opts.
+func (c *Client) cacheSettings(opts []OptionFunc) (ttl time.Duration, bypass bool) {
+	ttl = defaultCacheTTL
+	if opt, err := newOpt(opts...); err == nil {
+		if opt.CacheTTL > 0 {
+			ttl = opt.CacheTTL
+		}
+		bypass = opt.CacheBypass
+	}
+	return ttl, bypass
+}
+
+// SetCacheTTL overrides how long the response to this call is
+// considered fresh before it must be revalidated.
+func SetCacheTTL(d time.Duration) OptionFunc {
+	return func(opt *Option) error {
+		opt.CacheTTL = d
+		return nil
+	}
+}
+
+// SetCacheBypass skips the cache entirely for this call, both for
+// reading a cached response and for storing the one it fetches. Use it
+// for writes or while debugging a stale-cache suspicion.
+func SetCacheBypass() OptionFunc {
+	return func(opt *Option) error {
+		opt.CacheBypass = true
+		return nil
+	}
+}
+
+// revalidate re-requests url with conditional headers built from
+// entry, refreshing entry's TTL on a 304 or replacing it outright on a
+// fresh 200.
+func (c *Client) revalidate(ctx context.Context, url, key string, entry cache.Entry, ttl time.Duration, result interface{}) error {
+	var headers [][2]string
+	if entry.ETag != "" {
+		headers = append(headers, [2]string{"If-None-Match", entry.ETag})
+	}
+	if entry.LastModified != "" {
+		headers = append(headers, [2]string{"If-Modified-Since", entry.LastModified})
+	}
+
+	resp, err := c.doWithRetry(ctx, url, headers...)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		entry.Expires = time.Now().Add(ttl)
+		entry.TTL = ttl
+		c.cache.Set(key, entry)
+		return json.Unmarshal(entry.Body, result)
+	}
+
+	if err := c.checkError(resp); err != nil {
+		return err
+	}
+
+	b, err := ioutil.ReadAll(&ctxReader{ctx: ctx, r: resp.Body})
+	if err != nil {
+		return err
+	}
+
+	c.cache.Set(key, cache.Entry{
+		Body:         b,
+		Expires:      time.Now().Add(ttl),
+		TTL:          ttl,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	})
+
+	return json.Unmarshal(b, result)
+}
+
+// CacheMonitor periodically walks a Cache's keys and refreshes entries
+// nearing expiry so hot entities stay warm without a caller-triggered
+// request paying the cost of a cold miss.
+type CacheMonitor struct {
+	client   *Client
+	interval time.Duration
+
+	// refreshWithin is how far ahead of expiry an entry is eligible
+	// for a background refresh.
+	refreshWithin time.Duration
+}
+
+// StartCacheRefresh starts a CacheMonitor that wakes up every interval,
+// refreshing any entry within interval of expiring, until ctx is done.
+// It is a no-op if no cache has been configured with SetCache.
+func (c *Client) StartCacheRefresh(ctx context.Context, interval time.Duration) {
+	if c.cache == nil {
+		return
+	}
+
+	m := &CacheMonitor{client: c, interval: interval, refreshWithin: interval}
+	go m.run(ctx)
+}
+
+func (m *CacheMonitor) run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.refreshStale(ctx)
+		}
+	}
+}
+
+// refreshStale re-fetches every cached entry due to expire within
+// refreshWithin, including entries that have already expired. The URL
+// to re-request is the cache key with cacheKey's suffix stripped. A
+// refresh that fails is ignored and leaves the entry unchanged.
+func (m *CacheMonitor) refreshStale(ctx context.Context) {
+	now := time.Now()
+	for _, key := range m.client.cache.Keys() {
+		entry, ok := m.client.cache.Get(key)
+		if !ok {
+			continue
+		}
+		if entry.Expires.Sub(now) > m.refreshWithin {
+			continue
+		}
+
+		ttl := entry.TTL
+		if ttl <= 0 {
+			ttl = m.interval
+		}
+
+		url := stripCacheKeySuffix(key)
+		var raw json.RawMessage
+		_ = m.client.revalidate(ctx, url, key, entry, ttl, &raw)
+	}
+}
+
+// stripCacheKeySuffix undoes the "#<hash>" suffix cacheKey appends, so
+// the monitor can re-issue a request against the original URL.
+func stripCacheKeySuffix(key string) string {
+	if i := strings.LastIndexByte(key, '#'); i >= 0 {
+		return key[:i]
+	}
+	return key
+}
@@ -0,0 +1,94 @@
+package igdb
+
+import (
+	"context"
+	"sync"
+)
+
+// maxBatchSize is IGDB's limit on how many IDs can be requested in a
+// single multi-ID URL before it risks hitting a URL-length limit.
+const maxBatchSize = 50
+
+// GetGamesConcurrent fetches ids in chunks of at most maxBatchSize,
+// fanning the chunks out over a pool of concurrency workers and
+// merging the chunks' results in the order the chunks appear in ids.
+// Within a chunk, games are in the order the API returned them.
+//
+// A single large multiURL request is simpler and uses one round trip,
+// but once ids grows into the thousands the resulting URL can exceed
+// what IGDB or an intermediate proxy will accept; splitting into
+// parallel smaller requests trades that ceiling for a handful of extra
+// round trips that run concurrently instead of serially. If any chunk
+// errors, outstanding requests are canceled via ctx and the first error
+// is returned.
+func (c *Client) GetGamesConcurrent(ctx context.Context, ids []int, concurrency int, opts ...OptionFunc) ([]*Game, error) {
+	if len(ids) == 0 {
+		return nil, ErrEmptyIDs
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	chunks := chunkInts(ids, maxBatchSize)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([][]*Game, len(chunks))
+	var errOnce sync.Once
+	var firstErr error
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+dispatch:
+	for i, chunk := range chunks {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break dispatch
+		}
+
+		wg.Add(1)
+		go func(i int, chunk []int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			games, err := c.GetGamesContext(ctx, chunk, opts...)
+			if err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			results[i] = games
+		}(i, chunk)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	merged := make([]*Game, 0, len(ids))
+	for _, r := range results {
+		merged = append(merged, r...)
+	}
+	return merged, nil
+}
+
+// chunkInts splits ints into consecutive slices of at most size
+// elements each.
+func chunkInts(ints []int, size int) [][]int {
+	var chunks [][]int
+	for size < len(ints) {
+		ints, chunks = ints[size:], append(chunks, ints[0:size:size])
+	}
+	chunks = append(chunks, ints)
+	return chunks
+}
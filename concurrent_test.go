@@ -0,0 +1,70 @@
+package igdb
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const testGameSearch = "test_data/game_search.json"
+
+func TestChunkInts(t *testing.T) {
+	tests := []struct {
+		name string
+		ints []int
+		size int
+		want [][]int
+	}{
+		{"empty", nil, 2, [][]int{nil}},
+		{"smaller than size", []int{1, 2}, 5, [][]int{{1, 2}}},
+		{"exact multiple", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"remainder", []int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := chunkInts(test.ints, test.size)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Fatalf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
+
+func TestGetGamesConcurrent_EmptyIDsReturnsError(t *testing.T) {
+	c := NewClient()
+
+	games, err := c.GetGamesConcurrent(context.Background(), nil, 4)
+	if err != ErrEmptyIDs {
+		t.Fatalf("got err: <%v>, want: <%v>", err, ErrEmptyIDs)
+	}
+	if games != nil {
+		t.Fatalf("got: <%v>, want: <nil>", games)
+	}
+}
+
+func TestGetGamesConcurrent_MergesAllChunks(t *testing.T) {
+	ts, c, err := testServerFile(http.StatusOK, testGameSearch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ts.Close()
+
+	ids := make([]int, maxBatchSize*2+1)
+	for i := range ids {
+		ids[i] = i + 1
+	}
+
+	games, err := c.GetGamesConcurrent(context.Background(), ids, 4)
+	if err != nil {
+		t.Fatalf("got err: <%v>, want: <nil>", err)
+	}
+
+	// Every chunk hits the same stubbed response, so the merged result
+	// is the fixture's game count times the number of chunks.
+	wantChunks := len(chunkInts(ids, maxBatchSize))
+	if len(games) == 0 || len(games)%wantChunks != 0 {
+		t.Fatalf("got %d games, want a positive multiple of %d chunks", len(games), wantChunks)
+	}
+}
@@ -0,0 +1,60 @@
+package igdb
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+func TestCtxReader_ReadPassesThroughUntilDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r := &ctxReader{ctx: ctx, r: bytes.NewReader([]byte("hello"))}
+
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("got err: <%v>, want: <nil>", err)
+	}
+	if string(b) != "hello" {
+		t.Fatalf("got: <%s>, want: <hello>", b)
+	}
+}
+
+func TestCtxReader_ReadFailsOnceCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	r := &ctxReader{ctx: ctx, r: bytes.NewReader([]byte("hello"))}
+
+	_, err := r.Read(make([]byte, 5))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got: <%v>, want: <%v>", err, context.Canceled)
+	}
+}
+
+func TestCtxReader_ReadStopsMidStream(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	underlying := bytes.NewReader([]byte("0123456789"))
+	r := &ctxReader{ctx: ctx, r: underlying}
+
+	buf := make([]byte, 5)
+	n, err := r.Read(buf)
+	if err != nil || n != 5 {
+		t.Fatalf("got: (%d, %v), want: (5, nil)", n, err)
+	}
+
+	cancel()
+
+	_, err = r.Read(buf)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got: <%v>, want: <%v>", err, context.Canceled)
+	}
+	if err == io.EOF {
+		t.Fatalf("expected cancellation to preempt a natural EOF")
+	}
+}
@@ -0,0 +1,72 @@
+package igdb
+
+import (
+	"sync"
+	"time"
+)
+
+// deadline mirrors the cancellation pattern used internally by
+// net.Pipe's read/write deadlines: a single timer drives a cancel
+// channel that callers can select on, and resetting the deadline to the
+// zero Time disarms it cleanly without leaking a running timer.
+type deadline struct {
+	mu     sync.Mutex
+	timer  *time.Timer
+	cancel chan struct{}
+}
+
+// makeDeadline returns a deadline with an open (unarmed) cancel channel.
+func makeDeadline() deadline {
+	return deadline{cancel: make(chan struct{})}
+}
+
+// set arms the deadline for t, replacing any previously armed timer. A
+// zero Time disarms the deadline.
+func (d *deadline) set(t time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.timer != nil && !d.timer.Stop() {
+		<-d.cancel
+	}
+	d.timer = nil
+
+	closed := false
+	select {
+	case <-d.cancel:
+		closed = true
+	default:
+	}
+
+	if t.IsZero() {
+		if closed {
+			d.cancel = make(chan struct{})
+		}
+		return
+	}
+
+	dur := time.Until(t)
+	if dur <= 0 {
+		if !closed {
+			close(d.cancel)
+		}
+		return
+	}
+
+	if closed {
+		d.cancel = make(chan struct{})
+	}
+	cancel := d.cancel
+	d.timer = time.AfterFunc(dur, func() {
+		close(cancel)
+	})
+}
+
+// wait returns the channel that is closed once the deadline elapses.
+// The returned channel is only ever closed, never replaced while a
+// select is reading from it, so it is safe to hold on to across calls.
+func (d *deadline) wait() chan struct{} {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.cancel
+}
@@ -1,5 +1,7 @@
 package igdb
 
+import "context"
+
 type endpoint string
 
 // Endpoints to their respective IGDB endpoints
@@ -31,11 +33,17 @@ const (
 // GetEndpointModel returns a list of fields the represent the model
 // of the data available at the given IGDB endpoint.
 func (c *Client) GetEndpointModel(end endpoint) ([]string, error) {
+	return c.GetEndpointModelContext(context.Background(), end)
+}
+
+// GetEndpointModelContext is like GetEndpointModel but carries a ctx
+// that callers can use to set a deadline or cancel the request.
+func (c *Client) GetEndpointModelContext(ctx context.Context, end endpoint) ([]string, error) {
 	url := c.rootURL + string(end) + "meta"
 
 	var f []string
 
-	err := c.get(url, &f)
+	err := c.get(ctx, url, &f)
 	if err != nil {
 		return nil, err
 	}
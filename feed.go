@@ -1,5 +1,7 @@
 package igdb
 
+import "context"
+
 // Feed is
 type Feed struct {
 	ID          int          `json:"id"`
@@ -23,6 +25,12 @@ type Feed struct {
 
 // GetFeed gets IGDB information for a feed identified by its unique IGDB ID.
 func (c *Client) GetFeed(id int, opts ...OptionFunc) (*Feed, error) {
+	return c.GetFeedContext(context.Background(), id, opts...)
+}
+
+// GetFeedContext is like GetFeed but carries a ctx that callers can use
+// to set a deadline or cancel the request.
+func (c *Client) GetFeedContext(ctx context.Context, id int, opts ...OptionFunc) (*Feed, error) {
 	url, err := c.singleURL(FeedEndpoint, id, opts...)
 	if err != nil {
 		return nil, err
@@ -30,7 +38,7 @@ func (c *Client) GetFeed(id int, opts ...OptionFunc) (*Feed, error) {
 
 	var f []Feed
 
-	err = c.get(url, &f)
+	err = c.get(ctx, url, &f, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -41,6 +49,12 @@ func (c *Client) GetFeed(id int, opts ...OptionFunc) (*Feed, error) {
 // GetFeeds gets IGDB information for a list of game engines identified by their
 // unique IGDB IDs.
 func (c *Client) GetFeeds(ids []int, opts ...OptionFunc) ([]*Feed, error) {
+	return c.GetFeedsContext(context.Background(), ids, opts...)
+}
+
+// GetFeedsContext is like GetFeeds but carries a ctx that callers can
+// use to set a deadline or cancel the request.
+func (c *Client) GetFeedsContext(ctx context.Context, ids []int, opts ...OptionFunc) ([]*Feed, error) {
 	url, err := c.multiURL(FeedEndpoint, ids, opts...)
 	if err != nil {
 		return nil, err
@@ -48,7 +62,7 @@ func (c *Client) GetFeeds(ids []int, opts ...OptionFunc) ([]*Feed, error) {
 
 	var f []*Feed
 
-	err = c.get(url, &f)
+	err = c.get(ctx, url, &f, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1,5 +1,7 @@
 package igdb
 
+import "context"
+
 // AltName is
 type AltName struct {
 	Name    string `json:"name"`
@@ -107,13 +109,19 @@ type Game struct {
 
 // GetGame gets IGDB information for a game identified by their unique IGDB ID.
 func (c *Client) GetGame(id int, opts ...OptionFunc) (*Game, error) {
+	return c.GetGameContext(context.Background(), id, opts...)
+}
+
+// GetGameContext is like GetGame but carries a ctx that callers can use
+// to set a deadline or cancel the request.
+func (c *Client) GetGameContext(ctx context.Context, id int, opts ...OptionFunc) (*Game, error) {
 	url, err := c.singleURL(GameEndpoint, id, opts...)
 	if err != nil {
 		return nil, err
 	}
 	var g []Game
 
-	err = c.get(url, &g)
+	err = c.get(ctx, url, &g, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -123,13 +131,21 @@ func (c *Client) GetGame(id int, opts ...OptionFunc) (*Game, error) {
 
 // GetGames gets IGDB information for a list of games identified by a list of their unique IGDB IDs.
 func (c *Client) GetGames(ids []int, opts ...OptionFunc) ([]*Game, error) {
+	return c.GetGamesContext(context.Background(), ids, opts...)
+}
+
+// GetGamesContext is like GetGames but carries a ctx that callers can
+// use to set a deadline or cancel the request, which is especially
+// useful for large batches where the response body can take a while to
+// read in full.
+func (c *Client) GetGamesContext(ctx context.Context, ids []int, opts ...OptionFunc) ([]*Game, error) {
 	url, err := c.multiURL(GameEndpoint, ids, opts...)
 	if err != nil {
 		return nil, err
 	}
 	var g []*Game
 
-	err = c.get(url, &g)
+	err = c.get(ctx, url, &g, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -140,13 +156,19 @@ func (c *Client) GetGames(ids []int, opts ...OptionFunc) ([]*Game, error) {
 // SearchGames searches the IGDB using the given query and returns IGDB information
 // for the results. Use functional options for pagination and to sort results by parameter.
 func (c *Client) SearchGames(qry string, opts ...OptionFunc) ([]*Game, error) {
+	return c.SearchGamesContext(context.Background(), qry, opts...)
+}
+
+// SearchGamesContext is like SearchGames but carries a ctx that callers
+// can use to set a deadline or cancel the request.
+func (c *Client) SearchGamesContext(ctx context.Context, qry string, opts ...OptionFunc) ([]*Game, error) {
 	url, err := c.searchURL(GameEndpoint, qry, opts...)
 	if err != nil {
 		return nil, err
 	}
 	var g []*Game
 
-	err = c.get(url, &g)
+	err = c.get(ctx, url, &g, opts...)
 	if err != nil {
 		return nil, err
 	}
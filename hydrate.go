@@ -0,0 +1,286 @@
+package igdb
+
+import (
+	"context"
+	"reflect"
+)
+
+// hydrateField describes one []int reference field on a struct (e.g.
+// Game.Developers) and how to resolve it: which endpoint the IDs
+// belong to, and where to write the resolved results back.
+type hydrateField struct {
+	// name is the field's key for SetAutoHydrate/Hydrate's fields
+	// argument, lowercased (e.g. "developers").
+	name string
+	// idsField is the struct field holding the []int references.
+	idsField string
+	// fullField is the sibling struct field the resolved values are
+	// written to (e.g. DevelopersFull []*Company). It must exist on
+	// the same struct and be assignable from the endpoint's fetch
+	// result.
+	fullField string
+	endpoint  endpoint
+	// fetch batches ids through the matching Get<Entity>s call and
+	// returns them as a slice of pointers, in any order. These are the
+	// plain (non-Context) calls named in the backlog request; unlike
+	// GetGame/GetFeed, Company/Engine/Genre/Theme/Keyword/Franchise
+	// have no ...Context variant in this tree, the same gap chunk0-1
+	// found with Pulses, so Hydrate can't offer ctx cancellation for
+	// those lookups yet.
+	fetch func(c *Client, ids []int) (interface{}, error)
+}
+
+// gameHydrateFields enumerates every reference on Game that Hydrate
+// knows how to resolve. Expanding it is how support for a new
+// reference (e.g. Collection) gets added.
+var gameHydrateFields = []hydrateField{
+	{"developers", "Developers", "DevelopersFull", CompanyEndpoint, fetchCompanies},
+	{"publishers", "Publishers", "PublishersFull", CompanyEndpoint, fetchCompanies},
+	{"engines", "Engines", "EnginesFull", EngineEndpoint, fetchEngines},
+	{"genres", "Genres", "GenresFull", GenreEndpoint, fetchGenres},
+	{"themes", "Themes", "ThemesFull", ThemeEndpoint, fetchThemes},
+	{"keywords", "Keywords", "KeywordsFull", KeywordEndpoint, fetchKeywords},
+	{"franchises", "Franchises", "FranchisesFull", FranchiseEndpoint, fetchFranchises},
+	{"similargames", "SimilarGames", "SimilarGamesFull", GameEndpoint, fetchGames},
+	{"dlcs", "DLCs", "DLCsFull", GameEndpoint, fetchGames},
+	{"expansions", "Expansions", "ExpansionsFull", GameEndpoint, fetchGames},
+}
+
+func fetchCompanies(c *Client, ids []int) (interface{}, error) {
+	return c.GetCompanies(ids)
+}
+func fetchEngines(c *Client, ids []int) (interface{}, error) {
+	return c.GetEngines(ids)
+}
+func fetchGenres(c *Client, ids []int) (interface{}, error) {
+	return c.GetGenres(ids)
+}
+func fetchThemes(c *Client, ids []int) (interface{}, error) {
+	return c.GetThemes(ids)
+}
+func fetchKeywords(c *Client, ids []int) (interface{}, error) {
+	return c.GetKeywords(ids)
+}
+func fetchFranchises(c *Client, ids []int) (interface{}, error) {
+	return c.GetFranchises(ids)
+}
+func fetchGames(c *Client, ids []int) (interface{}, error) {
+	return c.GetGames(ids)
+}
+
+// ExpandedGame wraps a Game together with the sibling "Full" slices
+// Hydrate populates, keeping the expanded form out of Game itself so
+// that ordinary GetGame callers see no overhead.
+type ExpandedGame struct {
+	*Game
+
+	DevelopersFull   []*Company
+	PublishersFull   []*Company
+	EnginesFull      []*Engine
+	GenresFull       []*Genre
+	ThemesFull       []*Theme
+	KeywordsFull     []*Keyword
+	FranchisesFull   []*Franchise
+	SimilarGamesFull []*Game
+	DLCsFull         []*Game
+	ExpansionsFull   []*Game
+}
+
+// Hydrate resolves the nested IGDB ID references on target, which must
+// be a *Game, a []*Game, a *ExpandedGame, or a []*ExpandedGame, into
+// fully populated structs. fields restricts which references are
+// resolved (by the names in gameHydrateFields, e.g. "genres",
+// "developers"); with no fields given, every known reference is
+// resolved. Only an *ExpandedGame or []*ExpandedGame has Full fields to
+// receive the results; for a *Game or []*Game the references are
+// fetched and nothing on target is changed.
+//
+// IDs are deduplicated across the whole input set before being batched
+// through the plain Get<Entity>s calls named in gameHydrateFields, so
+// hydrating a slice of games that share a publisher only fetches that
+// publisher once. Hydrate takes no context and cannot be canceled
+// mid-fetch: Company/Engine/Genre/Theme/Keyword/Franchise have no
+// ...Context variant in this tree yet (the same gap chunk0-1 found
+// with Pulses). Each batched fetch goes through the client's cache, when
+// one is set with SetCache, like any other request: a later Hydrate
+// that needs exactly the same IDs for a reference is served from it,
+// but a set of IDs that only overlaps forms a different URL and is
+// fetched again.
+func (c *Client) Hydrate(target interface{}, fields ...string) error {
+	games, wrap := gamesOf(target)
+	if len(games) == 0 {
+		return nil
+	}
+
+	want := fieldSet(fields)
+
+	for _, hf := range gameHydrateFields {
+		if want != nil && !want[hf.name] {
+			continue
+		}
+
+		ids := collectIDs(games, hf.idsField)
+		if len(ids) == 0 {
+			continue
+		}
+
+		resolved, err := hf.fetch(c, ids)
+		if err != nil {
+			return err
+		}
+
+		if err := scatter(games, wrap, hf, resolved); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetAutoHydrate configures which references GetGameExpanded /
+// GetGameExpandedContext resolve when called without an explicit
+// fields list of their own. With no arguments, or if it is never
+// called, every known reference is resolved. A fields list passed to
+// GetGameExpanded / GetGameExpandedContext takes precedence over it.
+func (c *Client) SetAutoHydrate(fields ...string) {
+	c.autoHydrateFields = fields
+}
+
+// GetGameExpanded is like GetGame but also resolves the nested ID
+// references named in fields (or the fields configured by
+// SetAutoHydrate, or every known reference, if fields is empty) into
+// the returned ExpandedGame's sibling Full fields.
+func (c *Client) GetGameExpanded(id int, fields []string, opts ...OptionFunc) (*ExpandedGame, error) {
+	return c.GetGameExpandedContext(context.Background(), id, fields, opts...)
+}
+
+// GetGameExpandedContext is like GetGameExpanded but carries a ctx that
+// callers can use to set a deadline or cancel the request.
+func (c *Client) GetGameExpandedContext(ctx context.Context, id int, fields []string, opts ...OptionFunc) (*ExpandedGame, error) {
+	g, err := c.GetGameContext(ctx, id, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) == 0 {
+		fields = c.autoHydrateFields
+	}
+
+	exp := &ExpandedGame{Game: g}
+	if err := c.Hydrate(exp, fields...); err != nil {
+		return nil, err
+	}
+	return exp, nil
+}
+
+// fieldSet turns fields into a lookup set, or nil if fields is empty
+// (meaning "everything").
+func fieldSet(fields []string) map[string]bool {
+	if len(fields) == 0 {
+		return nil
+	}
+	set := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		set[f] = true
+	}
+	return set
+}
+
+// gamesOf normalizes target into the list of *Game it addresses, along
+// with the *ExpandedGame to populate for each, at the same index (nil
+// if target carries no Full fields, i.e. target is a bare
+// *Game/[]*Game).
+func gamesOf(target interface{}) ([]*Game, []*ExpandedGame) {
+	switch t := target.(type) {
+	case *Game:
+		return []*Game{t}, nil
+	case []*Game:
+		return t, nil
+	case *ExpandedGame:
+		return []*Game{t.Game}, []*ExpandedGame{t}
+	case []*ExpandedGame:
+		games := make([]*Game, len(t))
+		for i, e := range t {
+			games[i] = e.Game
+		}
+		return games, t
+	default:
+		return nil, nil
+	}
+}
+
+// collectIDs gathers the deduplicated union of the []int field named
+// idsField across games.
+func collectIDs(games []*Game, idsField string) []int {
+	seen := make(map[int]bool)
+	var ids []int
+
+	for _, g := range games {
+		v := reflect.ValueOf(g).Elem().FieldByName(idsField)
+		if !v.IsValid() || v.Kind() != reflect.Slice {
+			continue
+		}
+		for i := 0; i < v.Len(); i++ {
+			id := int(v.Index(i).Int())
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	return ids
+}
+
+// scatter writes the per-game slice of resolved values, filtered down
+// to each game's own reference IDs, into wrap[i]'s hf.fullField.
+func scatter(games []*Game, wrap []*ExpandedGame, hf hydrateField, resolved interface{}) error {
+	if wrap == nil {
+		// Nothing to populate; the caller hydrated a bare *Game/
+		// []*Game and only wanted the batched fetch to warm the cache.
+		return nil
+	}
+
+	byID := indexByID(resolved)
+
+	for i, g := range games {
+		idsVal := reflect.ValueOf(g).Elem().FieldByName(hf.idsField)
+		if !idsVal.IsValid() {
+			continue
+		}
+
+		fullVal := reflect.ValueOf(wrap[i]).Elem().FieldByName(hf.fullField)
+		if !fullVal.IsValid() || !fullVal.CanSet() {
+			continue
+		}
+
+		out := reflect.MakeSlice(fullVal.Type(), 0, idsVal.Len())
+		for j := 0; j < idsVal.Len(); j++ {
+			id := int(idsVal.Index(j).Int())
+			if v, ok := byID[id]; ok {
+				out = reflect.Append(out, v)
+			}
+		}
+		fullVal.Set(out)
+	}
+
+	return nil
+}
+
+// indexByID reflects over a []*T returned by a fetch func and indexes
+// it by each element's ID field.
+func indexByID(resolved interface{}) map[int]reflect.Value {
+	v := reflect.ValueOf(resolved)
+	index := make(map[int]reflect.Value, v.Len())
+
+	for i := 0; i < v.Len(); i++ {
+		el := v.Index(i)
+		id := el.Elem().FieldByName("ID")
+		if !id.IsValid() {
+			continue
+		}
+		index[int(id.Int())] = el
+	}
+
+	return index
+}
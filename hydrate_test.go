@@ -0,0 +1,117 @@
+package igdb
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFieldSet(t *testing.T) {
+	if got := fieldSet(nil); got != nil {
+		t.Fatalf("got: <%v>, want: <nil>", got)
+	}
+
+	got := fieldSet([]string{"genres", "themes"})
+	want := map[string]bool{"genres": true, "themes": true}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got: <%v>, want: <%v>", got, want)
+	}
+}
+
+func TestGamesOf(t *testing.T) {
+	g := &Game{ID: 1}
+	games, wrap := gamesOf(g)
+	if len(games) != 1 || games[0] != g || wrap != nil {
+		t.Fatalf("got: (%v, %v), want: ([g], nil)", games, wrap)
+	}
+
+	list := []*Game{{ID: 1}, {ID: 2}}
+	games, wrap = gamesOf(list)
+	if len(games) != 2 || wrap != nil {
+		t.Fatalf("got: (%v, %v), want: (list, nil)", games, wrap)
+	}
+
+	exp := &ExpandedGame{Game: &Game{ID: 3}}
+	games, wrap = gamesOf(exp)
+	if len(games) != 1 || games[0] != exp.Game || len(wrap) != 1 || wrap[0] != exp {
+		t.Fatalf("got: (%v, %v), want: ([exp.Game], [exp])", games, wrap)
+	}
+
+	expList := []*ExpandedGame{{Game: &Game{ID: 4}}, {Game: &Game{ID: 5}}}
+	games, wrap = gamesOf(expList)
+	if len(games) != 2 || games[0] != expList[0].Game || len(wrap) != 2 || wrap[1] != expList[1] {
+		t.Fatalf("got: (%v, %v), want: matching Game/ExpandedGame pointers", games, wrap)
+	}
+
+	games, wrap = gamesOf("not a game")
+	if games != nil || wrap != nil {
+		t.Fatalf("got: (%v, %v), want: (nil, nil)", games, wrap)
+	}
+}
+
+func TestCollectIDs_DedupsAcrossGames(t *testing.T) {
+	games := []*Game{
+		{DLCs: []int{1, 2}},
+		{DLCs: []int{2, 3}},
+	}
+
+	got := collectIDs(games, "DLCs")
+	want := []int{1, 2, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got: <%v>, want: <%v>", got, want)
+	}
+}
+
+func TestCollectIDs_UnknownFieldReturnsNil(t *testing.T) {
+	games := []*Game{{DLCs: []int{1}}}
+
+	got := collectIDs(games, "NoSuchField")
+	if got != nil {
+		t.Fatalf("got: <%v>, want: <nil>", got)
+	}
+}
+
+func TestScatter_PopulatesFullFieldFilteredByGameIDs(t *testing.T) {
+	g1 := &Game{ID: 1, DLCs: []int{10, 20}}
+	g2 := &Game{ID: 2, DLCs: []int{20}}
+	games := []*Game{g1, g2}
+	wrap := []*ExpandedGame{{Game: g1}, {Game: g2}}
+
+	hf := hydrateField{name: "dlcs", idsField: "DLCs", fullField: "DLCsFull", endpoint: GameEndpoint, fetch: fetchGames}
+
+	resolved := []*Game{{ID: 10}, {ID: 20}, {ID: 30}}
+
+	if err := scatter(games, wrap, hf, resolved); err != nil {
+		t.Fatalf("got err: <%v>, want: <nil>", err)
+	}
+
+	if len(wrap[0].DLCsFull) != 2 || wrap[0].DLCsFull[0].ID != 10 || wrap[0].DLCsFull[1].ID != 20 {
+		t.Fatalf("got: <%+v>, want: DLCs 10 and 20", wrap[0].DLCsFull)
+	}
+	if len(wrap[1].DLCsFull) != 1 || wrap[1].DLCsFull[0].ID != 20 {
+		t.Fatalf("got: <%+v>, want: DLC 20 only", wrap[1].DLCsFull)
+	}
+}
+
+func TestScatter_NilWrapIsNoop(t *testing.T) {
+	games := []*Game{{ID: 1, DLCs: []int{10}}}
+	hf := hydrateField{name: "dlcs", idsField: "DLCs", fullField: "DLCsFull", endpoint: GameEndpoint, fetch: fetchGames}
+
+	if err := scatter(games, nil, hf, []*Game{{ID: 10}}); err != nil {
+		t.Fatalf("got err: <%v>, want: <nil>", err)
+	}
+}
+
+func TestIndexByID(t *testing.T) {
+	resolved := []*Game{{ID: 1}, {ID: 2}}
+
+	index := indexByID(resolved)
+	if len(index) != 2 {
+		t.Fatalf("got %d entries, want: <2>", len(index))
+	}
+	if v, ok := index[1]; !ok || v.Interface().(*Game).ID != 1 {
+		t.Fatalf("got: <%v>, want: entry for ID 1", v)
+	}
+	if v, ok := index[2]; !ok || v.Interface().(*Game).ID != 2 {
+		t.Fatalf("got: <%v>, want: entry for ID 2", v)
+	}
+}
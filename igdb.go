@@ -1,12 +1,18 @@
 package igdb
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/pandoratoolbox/igdb/cache"
+	"github.com/pandoratoolbox/igdb/webhook"
 )
 
 // igdbURL is the base URL for the IGDB API.
@@ -16,6 +22,10 @@ const igdbURL string = "https://api-2445582011268.apicast.io/"
 // ID is used as an argument in an API call.
 var ErrNegativeID = errors.New("igdb.Client: negative ID")
 
+// ErrEmptyIDs is returned by a client when an empty list of IDs
+// is used as an argument in an API call.
+var ErrEmptyIDs = errors.New("igdb.Client: empty id list")
+
 // URL represents a URL as a string.
 type URL string
 
@@ -23,40 +33,158 @@ type URL string
 type Client struct {
 	http    *http.Client
 	rootURL string
+
+	timeout       time.Duration
+	readDeadline  deadline
+	writeDeadline deadline
+
+	cache cache.Cache
+
+	limiter    *tokenBucket
+	maxRetries int
+	quota      quota
+
+	autoHydrateFields []string
+
+	// Webhooks registers, lists, and deletes IGDB webhook
+	// subscriptions, e.g. c.Webhooks.Register(ctx,
+	// webhook.Endpoint(GameEndpoint), callbackURL, WebhookUpdate).
+	Webhooks *webhook.Service
 }
 
-// NewClient returns a new client.
-func NewClient() Client {
-	return Client{http: http.DefaultClient, rootURL: igdbURL}
+// NewClient returns a new client. It returns a *Client, not a Client,
+// because Client holds mutex-bearing fields (the read/write deadlines,
+// the request quota counters) that must never be copied.
+func NewClient() *Client {
+	c := &Client{
+		http:          http.DefaultClient,
+		rootURL:       igdbURL,
+		readDeadline:  makeDeadline(),
+		writeDeadline: makeDeadline(),
+		maxRetries:    defaultMaxRetries,
+	}
+	c.Webhooks = webhook.NewService(c.http, c.rootURL, func() string { return APIkey })
+	return c
 }
 
-// get sends a GET request to the url and stores the response
-// in the result interface{} if no errors are encountered.
-func (c *Client) get(url string, result interface{}) error {
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return err
+// SetTimeout sets the maximum duration allowed for each request made
+// through the client. It applies to every request, including those made
+// through the ...Context methods with a caller-supplied context; it is
+// not limited to calls such as GetGame or SearchGames that supply none.
+// A zero Duration removes the timeout, leaving cancellation entirely up
+// to the caller's context.
+func (c *Client) SetTimeout(d time.Duration) {
+	c.timeout = d
+}
+
+// SetReadDeadline cancels any request made through the client that is
+// still in flight at t. It cancels the request's whole context, so it is
+// not limited to reading the response body and acts the same way as
+// SetWriteDeadline. It applies to every request made through the client
+// until it is reset; passing the zero Time disarms it.
+func (c *Client) SetReadDeadline(t time.Time) {
+	c.readDeadline.set(t)
+}
+
+// SetWriteDeadline cancels any request made through the client that is
+// still in flight at t. It cancels the request's whole context, so it is
+// not limited to sending the request and also stops one that is already
+// reading its response. It applies to every request made through the
+// client until it is reset; passing the zero Time disarms it.
+func (c *Client) SetWriteDeadline(t time.Time) {
+	c.writeDeadline.set(t)
+}
+
+// deriveContext returns ctx, or context.Background() if ctx is nil,
+// layered with the client's timeout and read/write deadlines so that a
+// caller who did not supply a context is still bound by SetTimeout,
+// SetReadDeadline, and SetWriteDeadline.
+func (c *Client) deriveContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	cancels := make([]context.CancelFunc, 0, 3)
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		cancels = append(cancels, cancel)
+	}
+	for _, ch := range []chan struct{}{c.writeDeadline.wait(), c.readDeadline.wait()} {
+		var cancel context.CancelFunc
+		ctx, cancel = withDoneChannel(ctx, ch)
+		cancels = append(cancels, cancel)
 	}
 
-	req.Header.Add("user-key", APIkey)
-	req.Header.Add("Accept", "application/json")
+	return ctx, func() {
+		for _, cancel := range cancels {
+			cancel()
+		}
+	}
+}
+
+// get sends a GET request to the url and stores the response in the
+// result interface{} if no errors are encountered. ctx governs
+// cancellation; nil is treated as context.Background(). The client's
+// timeout and read/write deadlines, if any are set, are layered over ctx
+// whether or not the caller supplied one. opts is consulted for cache-specific
+// overrides (SetCacheTTL, SetCacheBypass); it need not be the same
+// slice used to build url.
+func (c *Client) get(ctx context.Context, url string, result interface{}, opts ...OptionFunc) error {
+	ctx, cancel := c.deriveContext(ctx)
+	defer cancel()
+
+	if c.cache == nil {
+		return c.fetch(ctx, url, nil, result)
+	}
 
-	resp, err := c.http.Do(req)
+	ttl, bypass := c.cacheSettings(opts)
+	key := c.cacheKey(url)
+
+	if !bypass {
+		if entry, ok := c.cache.Get(key); ok {
+			if time.Now().Before(entry.Expires) {
+				return json.Unmarshal(entry.Body, result)
+			}
+			return c.revalidate(ctx, url, key, entry, ttl, result)
+		}
+	}
+
+	if bypass {
+		return c.fetch(ctx, url, nil, result)
+	}
+	return c.fetch(ctx, url, &cacheWrite{key: key, ttl: ttl}, result)
+}
+
+// fetch performs the actual HTTP GET, honoring the rate limiter and
+// retrying 429/5xx responses with backoff. When w is non-nil the
+// response is also stored in the cache under w.key once it succeeds.
+func (c *Client) fetch(ctx context.Context, url string, w *cacheWrite, result interface{}) error {
+	resp, err := c.doWithRetry(ctx, url)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
-	err = c.checkError(resp)
-	if err != nil {
+	if err := c.checkError(resp); err != nil {
 		return err
 	}
 
-	b, err := ioutil.ReadAll(resp.Body)
+	b, err := ioutil.ReadAll(&ctxReader{ctx: ctx, r: resp.Body})
 	if err != nil {
 		return err
 	}
 
+	if w != nil {
+		c.cache.Set(w.key, cache.Entry{
+			Body:         b,
+			Expires:      time.Now().Add(w.ttl),
+			TTL:          w.ttl,
+			ETag:         resp.Header.Get("ETag"),
+			LastModified: resp.Header.Get("Last-Modified"),
+		})
+	}
+
 	err = json.Unmarshal(b, &result)
 	if err != nil {
 		return err
@@ -64,6 +192,51 @@ func (c *Client) get(url string, result interface{}) error {
 	return nil
 }
 
+// ctxReader wraps an io.Reader and fails with ctx.Err() as soon as ctx
+// is done, so a large response body (e.g. a big GetGames batch) stops
+// being read the moment the caller cancels rather than running to
+// completion first.
+type ctxReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r *ctxReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	n, err := r.r.Read(p)
+	if err == nil {
+		if cerr := r.ctx.Err(); cerr != nil {
+			return n, cerr
+		}
+	}
+	return n, err
+}
+
+// withDoneChannel returns a context that is canceled either when parent
+// is done or when done is closed, along with a cancel func that must be
+// called to release the goroutine backing it.
+func withDoneChannel(parent context.Context, done chan struct{}) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+	stop := make(chan struct{})
+
+	go func() {
+		select {
+		case <-done:
+			cancel()
+		case <-stop:
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, func() {
+		close(stop)
+		cancel()
+	}
+}
+
 // singleURL creates a URL configured to request a single IGDB entity
 // identified by its unique IGDB ID using the given endpoint.
 func (c *Client) singleURL(end endpoint, id int, opts ...OptionFunc) (string, error) {
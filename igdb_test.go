@@ -0,0 +1,54 @@
+package igdb
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestClient_DeriveContext_ReadDeadlineCancels(t *testing.T) {
+	c := NewClient()
+	c.SetReadDeadline(time.Now().Add(10 * time.Millisecond))
+
+	ctx, cancel := c.deriveContext(context.Background())
+	defer cancel()
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatalf("ctx was not canceled by the read deadline")
+	}
+	if ctx.Err() == nil {
+		t.Fatalf("got err: <nil>, want: non-nil")
+	}
+}
+
+func TestClient_DeriveContext_WriteDeadlineCancels(t *testing.T) {
+	c := NewClient()
+	c.SetWriteDeadline(time.Now().Add(10 * time.Millisecond))
+
+	ctx, cancel := c.deriveContext(context.Background())
+	defer cancel()
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatalf("ctx was not canceled by the write deadline")
+	}
+	if ctx.Err() == nil {
+		t.Fatalf("got err: <nil>, want: non-nil")
+	}
+}
+
+func TestClient_DeriveContext_NoDeadlinesLeavesContextLive(t *testing.T) {
+	c := NewClient()
+
+	ctx, cancel := c.deriveContext(context.Background())
+	defer cancel()
+
+	select {
+	case <-ctx.Done():
+		t.Fatalf("ctx was canceled with no deadlines set")
+	default:
+	}
+}
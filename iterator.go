@@ -0,0 +1,99 @@
+package igdb
+
+import (
+	"context"
+	"errors"
+)
+
+// defaultPageSize is how many results an Iterator requests per page.
+// Next appends SetLimit and SetOffset after the caller's options on
+// every page, so a limit or offset among those options does not change
+// the page size or the starting offset.
+const defaultPageSize = 50
+
+// GameIterator pages through the results of a game search, advancing
+// the offset by its page size until a short or empty page or an error
+// is reached. It is returned by IterateGames; use Next, Value, and Err to
+// drive it:
+//
+//	it := client.IterateGames("zelda")
+//	for it.Next(ctx) {
+//		game := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle err
+//	}
+type GameIterator struct {
+	client *Client
+	qry    string
+	opts   []OptionFunc
+
+	pageSize int
+	offset   int
+	page     []*Game
+	i        int
+	noMore   bool
+
+	cur  *Game
+	err  error
+	done bool
+}
+
+// IterateGames returns a GameIterator over the results of SearchGames
+// for qry, transparently paging through the result set page by page so
+// callers stop hand-rolling SetLimit/SetOffset loops.
+func (c *Client) IterateGames(qry string, opts ...OptionFunc) *GameIterator {
+	return &GameIterator{
+		client:   c,
+		qry:      qry,
+		opts:     opts,
+		pageSize: defaultPageSize,
+	}
+}
+
+// Next advances the iterator, fetching the next page over ctx when the
+// current one is exhausted. It returns false once there are no more
+// results or an error occurs; check Err afterward to distinguish the
+// two.
+func (it *GameIterator) Next(ctx context.Context) bool {
+	if it.err != nil || it.done {
+		return false
+	}
+
+	for it.i >= len(it.page) {
+		if it.noMore {
+			it.done = true
+			return false
+		}
+
+		opts := append(append([]OptionFunc{}, it.opts...), SetLimit(it.pageSize), SetOffset(it.offset))
+		page, err := it.client.SearchGamesContext(ctx, it.qry, opts...)
+		if err != nil && !errors.Is(err, ErrNoResults) {
+			it.err = err
+			return false
+		}
+		if errors.Is(err, ErrNoResults) || len(page) == 0 {
+			it.done = true
+			return false
+		}
+
+		it.page = page
+		it.i = 0
+		it.offset += len(page)
+		it.noMore = len(page) < it.pageSize
+	}
+
+	it.cur = it.page[it.i]
+	it.i++
+	return true
+}
+
+// Value returns the game Next just advanced to.
+func (it *GameIterator) Value() *Game {
+	return it.cur
+}
+
+// Err returns the first error encountered while paging, if any.
+func (it *GameIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,41 @@
+package igdb
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGameIterator_StopsCleanlyOnEmptyPage(t *testing.T) {
+	ts, c, err := testServerFile(http.StatusOK, testFileEmptyArray)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ts.Close()
+
+	it := c.IterateGames("zelda")
+
+	if it.Next(context.Background()) {
+		t.Fatalf("got: <true>, want: <false> (empty page should stop iteration)")
+	}
+	if it.Err() != nil {
+		t.Fatalf("got err: <%v>, want: <nil>", it.Err())
+	}
+}
+
+func TestGameIterator_PropagatesErrorFromPageFetch(t *testing.T) {
+	ts, c, err := testServerFile(http.StatusOK, testFileEmpty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ts.Close()
+
+	it := c.IterateGames("zelda")
+
+	if it.Next(context.Background()) {
+		t.Fatalf("got: <true>, want: <false> (a fetch error should stop iteration)")
+	}
+	if it.Err() == nil {
+		t.Fatalf("got err: <nil>, want: non-nil")
+	}
+}
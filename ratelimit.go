@@ -0,0 +1,212 @@
+package igdb
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// defaultMaxRetries is how many times fetch retries a request that
+// fails with a 429 or 5xx before giving up, when the caller has not
+// called SetMaxRetries.
+const defaultMaxRetries = 3
+
+// tokenBucket is a classic token-bucket limiter: tokens refill at rps
+// per second up to burst, and wait blocks until one is available or ctx
+// is done. It is safe for concurrent use, which matters once
+// GetGamesConcurrent is draining it from multiple goroutines at once.
+type tokenBucket struct {
+	mu       sync.Mutex
+	rps      float64
+	burst    float64
+	tokens   float64
+	lastFill time.Time
+}
+
+func newTokenBucket(rps, burst int) *tokenBucket {
+	return &tokenBucket{
+		rps:      float64(rps),
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		lastFill: time.Now(),
+	}
+}
+
+// wait blocks until a token is available or ctx is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		d := b.reserve()
+		if d <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(d)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// reserve refills the bucket for elapsed time and either takes a token
+// (returning 0) or reports how long the caller must wait for the next
+// one.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.tokens += now.Sub(b.lastFill).Seconds() * b.rps
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.lastFill = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+
+	missing := 1 - b.tokens
+	return time.Duration(missing / b.rps * float64(time.Second))
+}
+
+// quota tracks the remaining-quota counters IGDB's apicast gateway
+// surfaces on every response.
+type quota struct {
+	mu        sync.Mutex
+	remaining int
+	resetAt   time.Time
+}
+
+func (q *quota) set(remaining int, resetAt time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.remaining = remaining
+	q.resetAt = resetAt
+}
+
+func (q *quota) get() (int, time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.remaining, q.resetAt
+}
+
+// SetRateLimit installs a token-bucket limiter that allows at most rps
+// requests per second, with bursts up to burst, so an IterateGames loop
+// or a large GetGamesConcurrent batch cannot accidentally blow through
+// the monthly quota. Pass rps <= 0 to remove the limiter.
+func (c *Client) SetRateLimit(rps, burst int) {
+	if rps <= 0 {
+		c.limiter = nil
+		return
+	}
+	if burst < 1 {
+		burst = rps
+	}
+	c.limiter = newTokenBucket(rps, burst)
+}
+
+// SetMaxRetries caps how many times a request that fails with a 429 or
+// 5xx is retried with exponential backoff before fetch gives up and
+// returns the error to the caller.
+func (c *Client) SetMaxRetries(n int) {
+	c.maxRetries = n
+}
+
+// Quota returns the remaining request quota and when it resets, as last
+// reported by IGDB's X-RateLimit-Remaining / X-RateLimit-Reset headers.
+// It reads zero values until the first request completes.
+func (c *Client) Quota() (remaining int, resetAt time.Time) {
+	return c.quota.get()
+}
+
+// recordQuota updates the client's quota counters from resp's headers,
+// if present.
+func (c *Client) recordQuota(resp *http.Response) {
+	remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+
+	resetAt := time.Now()
+	if secs, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Reset")); err == nil {
+		resetAt = resetAt.Add(time.Duration(secs) * time.Second)
+	}
+
+	c.quota.set(remaining, resetAt)
+}
+
+// retryDelay returns how long to wait before retrying attempt (0-based)
+// after a 429/5xx, honoring Retry-After when the gateway sends one and
+// otherwise backing off exponentially with jitter.
+func retryDelay(resp *http.Response, attempt int) time.Duration {
+	if s := resp.Header.Get("Retry-After"); s != "" {
+		if secs, err := strconv.Atoi(s); err == nil {
+			return time.Duration(secs) * time.Second
+		}
+	}
+
+	base := time.Duration(1<<uint(attempt)) * 250 * time.Millisecond
+	jitter := time.Duration(rand.Int63n(int64(base) + 1))
+	return base + jitter
+}
+
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= 500
+}
+
+// doWithRetry builds and sends a GET request for url, applying the
+// client's rate limiter before every attempt and retrying a 429/5xx
+// response up to c.maxRetries times with backoff. It does not inspect
+// the response beyond its status code, so a caller that needs special
+// handling for a given status (e.g. 304 during cache revalidation) can
+// still apply it; the caller is responsible for closing the returned
+// response's body. headers are added to the request on every attempt,
+// e.g. conditional-request headers for a cache revalidation.
+func (c *Client) doWithRetry(ctx context.Context, url string, headers ...[2]string) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if c.limiter != nil {
+			if err := c.limiter.wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Add("user-key", APIkey)
+		req.Header.Add("Accept", "application/json")
+		for _, h := range headers {
+			req.Header.Add(h[0], h[1])
+		}
+
+		resp, err := c.http.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		c.recordQuota(resp)
+
+		if !isRetryableStatus(resp.StatusCode) || attempt >= c.maxRetries {
+			return resp, nil
+		}
+
+		delay := retryDelay(resp, attempt)
+		resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+}
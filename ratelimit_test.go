@@ -0,0 +1,120 @@
+package igdb
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTokenBucket_ReserveWithinBurstIsImmediate(t *testing.T) {
+	b := newTokenBucket(10, 3)
+
+	for i := 0; i < 3; i++ {
+		if d := b.reserve(); d != 0 {
+			t.Fatalf("reserve %d: got delay <%v>, want: <0>", i, d)
+		}
+	}
+
+	d := b.reserve()
+	if d <= 0 {
+		t.Fatalf("reserve after burst exhausted: got delay <%v>, want: > 0", d)
+	}
+}
+
+func TestTokenBucket_ReserveRefillsOverTime(t *testing.T) {
+	b := newTokenBucket(10, 1)
+
+	if d := b.reserve(); d != 0 {
+		t.Fatalf("got delay <%v>, want: <0>", d)
+	}
+
+	// Backdate lastFill instead of sleeping, so the test doesn't depend
+	// on real wall-clock waits.
+	b.mu.Lock()
+	b.lastFill = b.lastFill.Add(-200 * time.Millisecond)
+	b.mu.Unlock()
+
+	if d := b.reserve(); d != 0 {
+		t.Fatalf("got delay <%v> after refill window, want: <0>", d)
+	}
+}
+
+func TestTokenBucket_WaitReturnsOnceTokenAvailable(t *testing.T) {
+	b := newTokenBucket(1000, 1)
+	b.reserve() // drain the only token
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if err := b.wait(ctx); err != nil {
+		t.Fatalf("got err: <%v>, want: <nil>", err)
+	}
+}
+
+func TestTokenBucket_WaitAbortsOnCanceledContext(t *testing.T) {
+	b := newTokenBucket(1, 1)
+	b.reserve() // drain the only token, next reserve waits ~1s
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := b.wait(ctx); err != context.Canceled {
+		t.Fatalf("got: <%v>, want: <%v>", err, context.Canceled)
+	}
+}
+
+func TestRetryDelay_HonorsRetryAfterHeader(t *testing.T) {
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"2"}}}
+
+	d := retryDelay(resp, 0)
+	if d != 2*time.Second {
+		t.Fatalf("got: <%v>, want: <2s>", d)
+	}
+}
+
+func TestRetryDelay_BacksOffExponentiallyWithoutRetryAfter(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+
+	tests := []struct {
+		name    string
+		attempt int
+		min     time.Duration
+		max     time.Duration
+	}{
+		{"attempt 0", 0, 250 * time.Millisecond, 500 * time.Millisecond},
+		{"attempt 1", 1, 500 * time.Millisecond, time.Second},
+		{"attempt 2", 2, time.Second, 2 * time.Second},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := retryDelay(resp, test.attempt)
+			if d < test.min || d > test.max {
+				t.Fatalf("got: <%v>, want: between <%v> and <%v>", d, test.min, test.max)
+			}
+		})
+	}
+}
+
+func TestIsRetryableStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		code int
+		want bool
+	}{
+		{"too many requests", http.StatusTooManyRequests, true},
+		{"internal server error", http.StatusInternalServerError, true},
+		{"bad gateway", http.StatusBadGateway, true},
+		{"ok", http.StatusOK, false},
+		{"not found", http.StatusNotFound, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := isRetryableStatus(test.code); got != test.want {
+				t.Fatalf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
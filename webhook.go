@@ -0,0 +1,62 @@
+package igdb
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/pandoratoolbox/igdb/webhook"
+)
+
+// WebhookMethod re-exports webhook.Method so callers registering a
+// webhook don't need to import the subpackage just for the type.
+type WebhookMethod = webhook.Method
+
+// The entity actions IGDB will notify a webhook for.
+const (
+	WebhookCreate = webhook.MethodCreate
+	WebhookUpdate = webhook.MethodUpdate
+	WebhookDelete = webhook.MethodDelete
+)
+
+// WebhookHandler returns an http.Handler suitable for mounting at
+// whatever path a webhook was registered with. Beyond decoding and
+// verifying the callback and calling dispatch, it also deletes, if a
+// cache is configured, every cached single-entity response for the
+// affected entity, so e.g. an update to games/12345 never serves a
+// stale GetGame response. It does not re-fetch the entity.
+func (c *Client) WebhookHandler(secret string, dispatch func(webhook.Event)) http.Handler {
+	return webhook.Handler(secret, func(evt webhook.Event) {
+		c.invalidateForEvent(evt)
+		dispatch(evt)
+	})
+}
+
+// invalidateForEvent drops every cache entry for the single-entity URL
+// an event's endpoint/ID addresses, if a cache is configured. The next
+// GetGame/GetCompany/etc. call for that ID simply misses and re-fetches
+// fresh data, rather than trying to patch the cached JSON in place.
+//
+// A GetGame*-style call can be made with any number of OptionFunc query
+// parameters (field selection, and so on), each of which gives a
+// distinct URL and so a distinct cacheKey for the same entity. Since cacheKey is built from
+// the fully-encoded URL, this can't simply reconstruct one key to
+// delete — it has to walk every stored key and drop the ones whose
+// underlying URL is this entity's base URL, with or without a query
+// string attached.
+func (c *Client) invalidateForEvent(evt webhook.Event) {
+	if c.cache == nil {
+		return
+	}
+
+	base, err := c.singleURL(endpoint(evt.Endpoint), evt.ID)
+	if err != nil {
+		return
+	}
+
+	for _, key := range c.cache.Keys() {
+		url := stripCacheKeySuffix(key)
+		if url == base || strings.HasPrefix(url, base+"?") {
+			c.cache.Delete(key)
+		}
+	}
+}
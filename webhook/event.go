@@ -0,0 +1,39 @@
+// Package webhook implements IGDB's webhook registration API and a
+// ready-to-mount http.Handler for receiving the resulting callbacks.
+package webhook
+
+import "encoding/json"
+
+// Endpoint identifies the IGDB entity type a webhook is registered
+// against, e.g. "games/" or "companies/". It mirrors the root igdb
+// package's endpoint type but is exported here since webhook
+// registrations are a client-visible concept.
+type Endpoint string
+
+// Method is the IGDB entity action a webhook should fire on.
+type Method string
+
+// The entity actions IGDB will notify a webhook for.
+const (
+	MethodCreate Method = "create"
+	MethodUpdate Method = "update"
+	MethodDelete Method = "delete"
+)
+
+// Webhook is a single registration returned by the IGDB webhook API.
+type Webhook struct {
+	ID          int      `json:"id"`
+	Endpoint    Endpoint `json:"category"`
+	CallbackURL string   `json:"url"`
+	Method      Method   `json:"method"`
+	CreatedAt   int      `json:"created_at"`
+	SecretCode  string   `json:"secret"`
+}
+
+// Event is the decoded body of an incoming webhook callback.
+type Event struct {
+	Endpoint Endpoint
+	ID       int
+	Action   Method
+	Raw      json.RawMessage
+}
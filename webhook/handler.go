@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+)
+
+// signatureHeader is the header IGDB signs each callback body under,
+// keyed with the secret returned by Register.
+const signatureHeader = "X-Secret"
+
+// payload mirrors the fields IGDB sends on a webhook callback body
+// that Event needs; the rest of the entity is left in Event.Raw for
+// callers that want it.
+type payload struct {
+	ID       int    `json:"id"`
+	Category string `json:"category"`
+	SubCat   string `json:"sub_category"`
+	Action   Method `json:"method"`
+}
+
+// Handler returns an http.Handler suitable for mounting at whatever
+// path a Webhook was registered with. It verifies the request's
+// signature against secret, decodes the body into an Event, and calls
+// dispatch. Requests with a missing or invalid signature are rejected
+// with 401 and never reach dispatch.
+func Handler(secret string, dispatch func(Event)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "could not read body", http.StatusBadRequest)
+			return
+		}
+
+		if !validSignature(secret, b, r.Header.Get(signatureHeader)) {
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+
+		var p payload
+		if err := json.Unmarshal(b, &p); err != nil {
+			http.Error(w, "invalid payload", http.StatusBadRequest)
+			return
+		}
+
+		dispatch(Event{
+			Endpoint: Endpoint(p.Category),
+			ID:       p.ID,
+			Action:   p.Action,
+			Raw:      json.RawMessage(b),
+		})
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// validSignature reports whether sig is the hex-encoded HMAC-SHA256 of
+// body keyed with secret, using a constant-time comparison.
+func validSignature(secret string, body []byte, sig string) bool {
+	if sig == "" {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	want := hex.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(want), []byte(sig))
+}
@@ -0,0 +1,100 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestValidSignature(t *testing.T) {
+	body := []byte(`{"id":1}`)
+
+	tests := []struct {
+		name   string
+		secret string
+		body   []byte
+		sig    func() string
+		want   bool
+	}{
+		{"matching signature", "s3cr3t", body, func() string { return sign("s3cr3t", body) }, true},
+		{"wrong secret", "s3cr3t", body, func() string { return sign("wrong", body) }, false},
+		{"tampered body", "s3cr3t", body, func() string { return sign("s3cr3t", []byte(`{"id":2}`)) }, false},
+		{"empty signature", "s3cr3t", body, func() string { return "" }, false},
+		{"garbage signature", "s3cr3t", body, func() string { return "not-hex" }, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := validSignature(test.secret, test.body, test.sig()); got != test.want {
+				t.Fatalf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
+
+func TestHandler_RejectsInvalidSignature(t *testing.T) {
+	called := false
+	h := Handler("s3cr3t", func(Event) { called = true })
+
+	body := []byte(`{"id":1,"category":"games/","method":"update"}`)
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	req.Header.Set(signatureHeader, "bogus")
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("got status: <%d>, want: <%d>", rec.Code, http.StatusUnauthorized)
+	}
+	if called {
+		t.Fatalf("dispatch should not have been called")
+	}
+}
+
+func TestHandler_DispatchesOnValidSignature(t *testing.T) {
+	var got Event
+	h := Handler("s3cr3t", func(evt Event) { got = evt })
+
+	body := []byte(`{"id":42,"category":"games/","method":"update"}`)
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	req.Header.Set(signatureHeader, sign("s3cr3t", body))
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got status: <%d>, want: <%d>", rec.Code, http.StatusOK)
+	}
+	if got.ID != 42 || got.Endpoint != "games/" || got.Action != MethodUpdate {
+		t.Fatalf("got: <%+v>, want: id=42 endpoint=games/ action=update", got)
+	}
+}
+
+func TestHandler_RejectsInvalidPayload(t *testing.T) {
+	called := false
+	h := Handler("s3cr3t", func(Event) { called = true })
+
+	body := []byte(`not json`)
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	req.Header.Set(signatureHeader, sign("s3cr3t", body))
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("got status: <%d>, want: <%d>", rec.Code, http.StatusBadRequest)
+	}
+	if called {
+		t.Fatalf("dispatch should not have been called")
+	}
+}
@@ -0,0 +1,45 @@
+package webhook
+
+import "sync"
+
+// Registry fans a single stream of Events out to any number of
+// subscribers, modeled after the subscription routing added by feed
+// aggregators: each subscriber only sees the endpoints it asked for,
+// and subscribers don't need to know about one another.
+type Registry struct {
+	mu   sync.Mutex
+	subs map[Endpoint][]func(Event)
+	all  []func(Event)
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{subs: make(map[Endpoint][]func(Event))}
+}
+
+// Subscribe registers fn to be called with every Event for end. If end
+// is the empty Endpoint, fn observes every event regardless of
+// endpoint.
+func (r *Registry) Subscribe(end Endpoint, fn func(Event)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if end == "" {
+		r.all = append(r.all, fn)
+		return
+	}
+	r.subs[end] = append(r.subs[end], fn)
+}
+
+// Dispatch calls every subscriber observing evt's endpoint, in the
+// order they were subscribed; subscribers registered with Subscribe("",
+// ...) run first.
+func (r *Registry) Dispatch(evt Event) {
+	r.mu.Lock()
+	fns := append(append([]func(Event){}, r.all...), r.subs[evt.Endpoint]...)
+	r.mu.Unlock()
+
+	for _, fn := range fns {
+		fn(evt)
+	}
+}
@@ -0,0 +1,64 @@
+package webhook
+
+import "testing"
+
+func TestRegistry_DispatchToEndpointSubscriber(t *testing.T) {
+	r := NewRegistry()
+
+	var got []Event
+	r.Subscribe("games/", func(evt Event) { got = append(got, evt) })
+
+	r.Dispatch(Event{Endpoint: "games/", ID: 1})
+	r.Dispatch(Event{Endpoint: "companies/", ID: 2})
+
+	if len(got) != 1 || got[0].ID != 1 {
+		t.Fatalf("got: <%+v>, want: exactly one event with ID 1", got)
+	}
+}
+
+func TestRegistry_CatchAllSubscriberSeesEveryEndpoint(t *testing.T) {
+	r := NewRegistry()
+
+	var got []Event
+	r.Subscribe("", func(evt Event) { got = append(got, evt) })
+
+	r.Dispatch(Event{Endpoint: "games/", ID: 1})
+	r.Dispatch(Event{Endpoint: "companies/", ID: 2})
+
+	if len(got) != 2 {
+		t.Fatalf("got %d events, want: <2>", len(got))
+	}
+}
+
+func TestRegistry_CatchAllRunsBeforeEndpointSpecific(t *testing.T) {
+	r := NewRegistry()
+
+	var order []string
+	r.Subscribe("games/", func(Event) { order = append(order, "specific") })
+	r.Subscribe("", func(Event) { order = append(order, "catch-all") })
+
+	r.Dispatch(Event{Endpoint: "games/"})
+
+	if len(order) != 2 || order[0] != "catch-all" || order[1] != "specific" {
+		t.Fatalf("got: <%v>, want: <[catch-all specific]>", order)
+	}
+}
+
+func TestRegistry_SubscribersCalledInSubscribeOrder(t *testing.T) {
+	r := NewRegistry()
+
+	var order []string
+	r.Subscribe("games/", func(Event) { order = append(order, "first") })
+	r.Subscribe("games/", func(Event) { order = append(order, "second") })
+
+	r.Dispatch(Event{Endpoint: "games/"})
+
+	if len(order) != 2 || order[0] != "first" || order[1] != "second" {
+		t.Fatalf("got: <%v>, want: <[first second]>", order)
+	}
+}
+
+func TestRegistry_DispatchWithNoSubscribersDoesNothing(t *testing.T) {
+	r := NewRegistry()
+	r.Dispatch(Event{Endpoint: "games/"}) // should not panic
+}
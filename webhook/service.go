@@ -0,0 +1,117 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// Service registers, lists, and deletes webhooks against the IGDB
+// webhook API. It is deliberately decoupled from the root igdb.Client
+// so that this package never has to import it; construct one with
+// NewService, typically once from igdb.Client.Webhooks.
+type Service struct {
+	http    *http.Client
+	rootURL string
+	keyFunc func() string
+}
+
+// NewService returns a Service that sends requests through httpClient
+// to rootURL, authenticating with whatever key keyFunc returns at call
+// time (so a caller that rotates the key after construction is still
+// picked up).
+func NewService(httpClient *http.Client, rootURL string, keyFunc func() string) *Service {
+	return &Service{http: httpClient, rootURL: rootURL, keyFunc: keyFunc}
+}
+
+func (s *Service) newRequest(ctx context.Context, method, url string, body interface{}) (*http.Request, error) {
+	var r *bytes.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		r = bytes.NewReader(b)
+	} else {
+		r = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, r)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("user-key", s.keyFunc())
+	req.Header.Add("Accept", "application/json")
+	if body != nil {
+		req.Header.Add("Content-Type", "application/json")
+	}
+	return req, nil
+}
+
+// Register subscribes callbackURL to fire on method events for end,
+// returning the created Webhook (including the secret IGDB will sign
+// callbacks with).
+func (s *Service) Register(ctx context.Context, end Endpoint, callbackURL string, method Method) (*Webhook, error) {
+	req, err := s.newRequest(ctx, http.MethodPost, s.rootURL+string(end)+"webhooks", map[string]string{
+		"url":    callbackURL,
+		"method": string(method),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var w Webhook
+	if err := s.do(req, &w); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+// List returns every webhook currently registered for end.
+func (s *Service) List(ctx context.Context, end Endpoint) ([]*Webhook, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, s.rootURL+string(end)+"webhooks", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var w []*Webhook
+	if err := s.do(req, &w); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Delete removes the webhook identified by id from end.
+func (s *Service) Delete(ctx context.Context, end Endpoint, id int) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, fmt.Sprintf("%s%swebhooks/%d", s.rootURL, end, id), nil)
+	if err != nil {
+		return err
+	}
+	return s.do(req, nil)
+}
+
+func (s *Service) do(req *http.Request, result interface{}) error {
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("igdb.webhook: %s: %s", resp.Status, b)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, result)
+}